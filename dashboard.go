@@ -0,0 +1,200 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+type dashboardOptions struct {
+	Team    string
+	Orgs    []string
+	Refresh time.Duration
+}
+
+func dashboardCmd() *cobra.Command {
+	opts := dashboardOptions{}
+	cmd := &cobra.Command{
+		Use:     "dashboard <org>/<team>",
+		Aliases: []string{"watch"},
+		Short:   "watch team statuses in an interactive dashboard",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Team = args[0]
+			}
+			if opts.Team == "" && len(opts.Orgs) == 0 {
+				return fmt.Errorf("specify a <org>/<team> or at least one --org")
+			}
+			return runDashboard(opts)
+		},
+	}
+	cmd.Flags().StringArrayVar(&opts.Orgs, "org", nil, "watch additional <org>/<team> targets alongside the primary one")
+	cmd.Flags().DurationVar(&opts.Refresh, "refresh", 30*time.Second, "how often to refresh statuses")
+
+	return cmd
+}
+
+func runDashboard(opts dashboardOptions) error {
+	for {
+		p := tea.NewProgram(newDashboardModel(opts), tea.WithAltScreen())
+		final, err := p.Run()
+		if err != nil {
+			return err
+		}
+
+		if !final.(dashboardModel).wantsSetStatus {
+			return nil
+		}
+
+		// The alt screen is gone now, so survey can read the real terminal.
+		em := newEmojiManager()
+		setOpts := setOptions{}
+		if err := prompt(em, &setOpts); err != nil {
+			return err
+		}
+		if err := runSet(setOpts); err != nil {
+			return err
+		}
+	}
+}
+
+type dashboardItem struct {
+	member memberStatus
+}
+
+func (i dashboardItem) Title() string {
+	availability := ""
+	if i.member.IndicatesLimitedAvailability {
+		availability = " (limited)"
+	}
+	return i.member.User.Login + availability
+}
+
+func (i dashboardItem) Description() string {
+	return newEmojiManager().ReplaceAll(fmt.Sprintf("%s %s", i.member.Emoji, i.member.Message))
+}
+
+func (i dashboardItem) FilterValue() string { return i.member.User.Login }
+
+type dashboardModel struct {
+	opts           dashboardOptions
+	list           list.Model
+	members        []memberStatus
+	onlyLimited    bool
+	err            error
+	lastRefresh    time.Time
+	wantsSetStatus bool
+}
+
+type refreshMsg struct {
+	members []memberStatus
+	err     error
+}
+
+func newDashboardModel(opts dashboardOptions) dashboardModel {
+	l := list.New(nil, list.NewDefaultDelegate(), 0, 0)
+	l.Title = "gh user-status dashboard"
+	return dashboardModel{opts: opts, list: l}
+}
+
+func (m dashboardModel) Init() tea.Cmd {
+	return tea.Batch(m.fetch(), tea.Tick(m.opts.Refresh, func(t time.Time) tea.Msg { return t }))
+}
+
+func (m dashboardModel) fetch() tea.Cmd {
+	return func() tea.Msg {
+		members, err := fetchDashboardMembers(m.opts)
+		return refreshMsg{members: members, err: err}
+	}
+}
+
+func (m dashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.list.SetSize(msg.Width, msg.Height-2)
+		return m, nil
+	case time.Time:
+		return m, tea.Batch(m.fetch(), tea.Tick(m.opts.Refresh, func(t time.Time) tea.Msg { return t }))
+	case refreshMsg:
+		m.err = msg.err
+		m.lastRefresh = time.Now()
+		if msg.err == nil {
+			m.members = msg.members
+			m.list.SetItems(m.dashboardItems())
+		}
+		return m, nil
+	case tea.KeyMsg:
+		if m.list.FilterState() != list.Filtering {
+			switch msg.String() {
+			case "q", "ctrl+c":
+				return m, tea.Quit
+			case "f":
+				m.onlyLimited = !m.onlyLimited
+				m.list.SetItems(m.dashboardItems())
+				return m, nil
+			case "s":
+				m.wantsSetStatus = true
+				return m, tea.Quit
+			case "r":
+				return m, m.fetch()
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m dashboardModel) dashboardItems() []list.Item {
+	items := make([]list.Item, 0, len(m.members))
+	for _, mem := range m.members {
+		if m.onlyLimited && !mem.IndicatesLimitedAvailability {
+			continue
+		}
+		items = append(items, dashboardItem{member: mem})
+	}
+	return items
+}
+
+func (m dashboardModel) View() string {
+	help := lipgloss.NewStyle().Faint(true).Render("↑/↓ navigate · /: filter · f: toggle limited-only · s: set my status · r: refresh · q: quit")
+	if m.err != nil {
+		return fmt.Sprintf("%s\nerror: %s\n%s", m.list.View(), m.err, help)
+	}
+	return fmt.Sprintf("%s\n%s", m.list.View(), help)
+}
+
+// fetchDashboardMembers resolves the primary team plus any --org targets (each
+// also given as <org>/<team>) into a combined, sorted list of member statuses.
+func fetchDashboardMembers(opts dashboardOptions) ([]memberStatus, error) {
+	var all []memberStatus
+
+	targets := opts.Orgs
+	if opts.Team != "" {
+		targets = append([]string{opts.Team}, targets...)
+	}
+
+	for _, target := range targets {
+		arr := strings.SplitN(target, "/", 2)
+		if len(arr) != 2 {
+			return nil, fmt.Errorf("expected <org>/<team>, got %q", target)
+		}
+		nodes, err := apiTeam(arr[0], arr[1], 0)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, *nodes...)
+	}
+
+	sort.Slice(all, func(i, j int) bool { return all[i].User.Login < all[j].User.Login })
+
+	return all, nil
+}
@@ -98,6 +98,8 @@ func prompt(em emojiManager, opts *setOptions) error {
 
 func setCmd() *cobra.Command {
 	opts := setOptions{}
+	var fromFile string
+	var template string
 	cmd := &cobra.Command{
 		Use:   "set <status>",
 		Short: "set your GitHub status",
@@ -107,6 +109,21 @@ func setCmd() *cobra.Command {
 				opts.Message = args[0]
 			}
 
+			switch {
+			case fromFile != "":
+				if !cmd.Flags().Changed("emoji") {
+					opts.Emoji = ""
+				}
+				if err := loadSetOptionsFromFile(fromFile, &opts); err != nil {
+					return err
+				}
+			case template != "":
+				if !cmd.Flags().Changed("emoji") {
+					opts.Emoji = ""
+				}
+				return applySetOptionsFromTemplate(template, &opts)
+			}
+
 			em := newEmojiManager()
 			if opts.Message == "" {
 				err := prompt(em, &opts)
@@ -121,15 +138,16 @@ func setCmd() *cobra.Command {
 	cmd.Flags().BoolVarP(&opts.Limited, "limited", "l", false, "Indicate limited availability")
 	cmd.Flags().DurationVarP(&opts.Expiry, "expiry", "E", time.Duration(0), "Expire status after this duration")
 	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "Limit status visibility to an organization")
+	cmd.Flags().StringVar(&fromFile, "from-file", "", "set status from a YAML or JSON file")
+	cmd.Flags().StringVar(&template, "template", "", "set status from a saved preset (see 'presets')")
 
 	return cmd
 }
 
 func runSet(opts setOptions) error {
 	em := newEmojiManager()
-	// TODO org flag -- punted on this bc i have to resolve an org ID and it didn't feel worth it.
-	mutation := `mutation($emoji: String!, $message: String!, $limited: Boolean!, $expiry: DateTime) {
-		changeUserStatus(input: {emoji: $emoji, message: $message, limitedAvailability: $limited, expiresAt: $expiry}) {
+	mutation := `mutation($emoji: String!, $message: String!, $limited: Boolean!, $expiry: DateTime, $organizationId: ID) {
+		changeUserStatus(input: {emoji: $emoji, message: $message, limitedAvailability: $limited, expiresAt: $expiry, organizationId: $organizationId}) {
 			status {
 				message
 				emoji
@@ -152,6 +170,15 @@ func runSet(opts setOptions) error {
 		emoji = fmt.Sprintf(":%s:", opts.Emoji)
 	}
 
+	organizationID := "null"
+	if opts.OrgName != "" {
+		id, err := resolveOrgID(opts.OrgName)
+		if err != nil {
+			return err
+		}
+		organizationID = id
+	}
+
 	cmdArgs := []string{
 		"api", "graphql",
 		"-f", fmt.Sprintf("query=%s", mutation),
@@ -159,6 +186,7 @@ func runSet(opts setOptions) error {
 		"-f", fmt.Sprintf("emoji=%s", emoji),
 		"-F", fmt.Sprintf("limited=%s", limited),
 		"-F", fmt.Sprintf("expiry=%s", expiry),
+		"-F", fmt.Sprintf("organizationId=%s", organizationID),
 	}
 
 	out, stderr, err := gh(cmdArgs...)
@@ -225,28 +253,39 @@ func clearCmd() *cobra.Command {
 }
 
 type getOptions struct {
-	Login string
+	Login      string
+	Limit      int
+	OnlyActive bool
+	Output     outputOptions
 }
 
 func getCmd() *cobra.Command {
-	return &cobra.Command{
+	opts := getOptions{}
+	cmd := &cobra.Command{
 		Use:   "get [<username>]",
 		Short: "get a GitHub user's status or your own",
 		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts := getOptions{}
 			if len(args) > 0 {
 				opts.Login = args[0]
 			}
 			return runGet(opts)
 		},
 	}
+	cmd.Flags().IntVar(&opts.Limit, "limit", 0, "maximum number of team members to show (0 for no limit)")
+	cmd.Flags().BoolVar(&opts.OnlyActive, "only-active", false, "only show members with a status set")
+	cmd.Flags().Var(&opts.Output.JSON, "json", fmt.Sprintf("output JSON restricted to the given fields (%s)", strings.Join(statusRecordFields, ",")))
+	cmd.Flags().StringVar(&opts.Output.Jq, "jq", "", "filter JSON output using a jq expression")
+	cmd.Flags().StringVar(&opts.Output.Template, "template", "", "format JSON output using a Go template")
+
+	return cmd
 }
 
 type status struct {
 	IndicatesLimitedAvailability bool
 	Message                      string
 	Emoji                        string
+	ExpiresAt                    string
 }
 
 func runGet(opts getOptions) error {
@@ -260,13 +299,46 @@ func runGet(opts getOptions) error {
 func runGetTeam(opts getOptions) error {
 	arr := strings.Split(opts.Login, "/")
 	login, slug := arr[0], arr[1]
-	nodes, err := apiTeam(login, slug)
+
+	// --only-active filters after the fetch, so capping apiTeam's fetch to
+	// --limit here could leave fewer than --limit active members. Only let
+	// apiTeam apply the limit itself (to stop paginating early) when there's
+	// no filter to apply afterward.
+	fetchLimit := opts.Limit
+	if opts.OnlyActive {
+		fetchLimit = 0
+	}
+
+	nodes, err := apiTeam(login, slug, fetchLimit)
 	if err != nil {
 		return err
 	}
 
-	em := newEmojiManager()
+	members := make([]memberStatus, 0, len(*nodes))
 	for _, n := range *nodes {
+		if opts.OnlyActive && n.Message == "" {
+			continue
+		}
+		members = append(members, n)
+	}
+	if opts.Limit > 0 && len(members) > opts.Limit {
+		members = members[:opts.Limit]
+	}
+
+	em := newEmojiManager()
+
+	var records []statusRecord
+	for _, n := range members {
+		if opts.Output.structured() {
+			records = append(records, newStatusRecord(em, n.User.Login, status{
+				IndicatesLimitedAvailability: n.IndicatesLimitedAvailability,
+				Message:                      n.Message,
+				Emoji:                        n.Emoji,
+				ExpiresAt:                    n.ExpiresAt,
+			}))
+			continue
+		}
+
 		availability := ""
 		if n.IndicatesLimitedAvailability {
 			availability = "(availability is limited)"
@@ -276,6 +348,10 @@ func runGetTeam(opts getOptions) error {
 		fmt.Println(em.ReplaceAll(msg))
 	}
 
+	if opts.Output.structured() {
+		return renderOutput(opts.Output, records)
+	}
+
 	return nil
 }
 
@@ -286,6 +362,14 @@ func runGetUser(opts getOptions) error {
 		return err
 	}
 
+	if opts.Output.structured() {
+		login := opts.Login
+		if login == "" {
+			login = "@me"
+		}
+		return renderOutput(opts.Output, []statusRecord{newStatusRecord(em, login, *s)})
+	}
+
 	availability := ""
 	if s.IndicatesLimitedAvailability {
 		availability = "(availability is limited)"
@@ -301,61 +385,92 @@ type memberStatus struct {
 	IndicatesLimitedAvailability bool
 	Message                      string
 	Emoji                        string
+	ExpiresAt                    string
 	User                         struct {
 		Login string
 	}
 }
 
-func apiTeam(login string, slug string) (*[]memberStatus, error) {
+// apiTeam fetches member statuses for login/slug, paging through
+// memberStatuses with a cursor until either GitHub runs out of members or
+// limit is reached. A limit of 0 means no cap.
+func apiTeam(login string, slug string, limit int) (*[]memberStatus, error) {
 	if login == "" {
 		login = "{owner}"
 	}
-	// TODO: supports over 100 members
-	query := fmt.Sprintf(
-		`query {
+
+	var nodes []memberStatus
+	cursor := ""
+	for {
+		after := "null"
+		if cursor != "" {
+			after = fmt.Sprintf("%q", cursor)
+		}
+
+		query := fmt.Sprintf(
+			`query {
       organization(login:"%s") {
         team(slug:"%s") {
-          memberStatuses(first: 100) {
-            nodes { indicatesLimitedAvailability message emoji user { login } }
+          memberStatuses(first: 100, after: %s) {
+            pageInfo { hasNextPage endCursor }
+            nodes { indicatesLimitedAvailability message emoji expiresAt user { login } }
           }
         }
       }
-    }`, login, slug)
+    }`, login, slug, after)
 
-	args := []string{"api", "graphql", "-f", fmt.Sprintf("query=%s", query)}
-	sout, _, err := gh(args...)
-	if err != nil {
-		return nil, err
-	}
+		args := []string{"api", "graphql", "-f", fmt.Sprintf("query=%s", query)}
+		sout, _, err := gh(args...)
+		if err != nil {
+			return nil, err
+		}
 
-	type response struct {
-		Data struct {
-			Organization struct {
-				Team struct {
-					MemberStatuses struct {
-						Nodes []memberStatus
+		type response struct {
+			Data struct {
+				Organization struct {
+					Team struct {
+						MemberStatuses struct {
+							PageInfo struct {
+								HasNextPage bool
+								EndCursor   string
+							}
+							Nodes []memberStatus
+						}
 					}
 				}
 			}
 		}
-	}
-	var resp response
-	err = json.Unmarshal(sout.Bytes(), &resp)
-	if err != nil {
-		return nil, fmt.Errorf("failed to deserialize JSON: %w", err)
+		var resp response
+		err = json.Unmarshal(sout.Bytes(), &resp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to deserialize JSON: %w", err)
+		}
+
+		nodes = append(nodes, resp.Data.Organization.Team.MemberStatuses.Nodes...)
+
+		if limit > 0 && len(nodes) >= limit {
+			nodes = nodes[:limit]
+			break
+		}
+
+		pageInfo := resp.Data.Organization.Team.MemberStatuses.PageInfo
+		if !pageInfo.HasNextPage {
+			break
+		}
+		cursor = pageInfo.EndCursor
 	}
 
-	return &resp.Data.Organization.Team.MemberStatuses.Nodes, nil
+	return &nodes, nil
 }
 
 func apiStatus(login string) (*status, error) {
 	key := "user"
 	query := fmt.Sprintf(
-		`query { user(login:"%s") { status { indicatesLimitedAvailability message emoji }}}`,
+		`query { user(login:"%s") { status { indicatesLimitedAvailability message emoji expiresAt }}}`,
 		login)
 	if login == "" {
 		key = "viewer"
-		query = `query {viewer { status { indicatesLimitedAvailability message emoji }}}`
+		query = `query {viewer { status { indicatesLimitedAvailability message emoji expiresAt }}}`
 	}
 
 	args := []string{"api", "graphql", "-f", fmt.Sprintf("query=%s", query)}
@@ -384,6 +499,9 @@ func main() {
 	rc.AddCommand(setCmd())
 	rc.AddCommand(clearCmd())
 	rc.AddCommand(getCmd())
+	rc.AddCommand(dashboardCmd())
+	rc.AddCommand(presetsCmd())
+	rc.AddCommand(scheduleCmd())
 
 	if err := rc.Execute(); err != nil {
 		// TODO not bothering as long as cobra is also printing error
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// orgIDCachePath returns the on-disk location used to cache resolved org
+// node IDs, alongside gh's own config (e.g. ~/.config/gh/user-status-orgs.json).
+func orgIDCachePath() (string, error) {
+	dir := os.Getenv("GH_CONFIG_DIR")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config", "gh")
+	}
+	return filepath.Join(dir, "user-status-orgs.json"), nil
+}
+
+func readOrgIDCache() (map[string]string, error) {
+	path, err := orgIDCachePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+
+	cache := map[string]string{}
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return cache, nil
+}
+
+func writeOrgIDCache(cache map[string]string) error {
+	path, err := orgIDCachePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+// resolveOrgID looks up the GraphQL node ID for an organization login, caching
+// the result on disk so repeated invocations of `set --org` don't round-trip.
+func resolveOrgID(login string) (string, error) {
+	cache, err := readOrgIDCache()
+	if err != nil {
+		return "", err
+	}
+
+	if id, ok := cache[login]; ok {
+		return id, nil
+	}
+
+	query := fmt.Sprintf(`query { organization(login:"%s") { id } }`, login)
+	args := []string{"api", "graphql", "-f", fmt.Sprintf("query=%s", query)}
+	sout, _, err := gh(args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve org %q (are you a member?): %w", login, err)
+	}
+
+	var resp struct {
+		Data struct {
+			Organization struct {
+				ID string
+			}
+		}
+	}
+	if err := json.Unmarshal(sout.Bytes(), &resp); err != nil {
+		return "", fmt.Errorf("failed to deserialize JSON: %w", err)
+	}
+	if resp.Data.Organization.ID == "" {
+		return "", fmt.Errorf("you don't appear to be a member of %q", login)
+	}
+
+	cache[login] = resp.Data.Organization.ID
+	if err := writeOrgIDCache(cache); err != nil {
+		return "", err
+	}
+
+	return resp.Data.Organization.ID, nil
+}
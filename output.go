@@ -0,0 +1,295 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/itchyny/gojq"
+)
+
+// statusRecordFields lists the JSON fields a statusRecord can expose, in the
+// order they're rendered when --jq or --template is used without --json.
+var statusRecordFields = []string{
+	"login",
+	"message",
+	"emoji",
+	"emojiCodepoint",
+	"limitedAvailability",
+	"expiresAt",
+}
+
+func isStatusRecordField(name string) bool {
+	for _, f := range statusRecordFields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonFields implements pflag.Value for --json, mirroring gh's `--json
+// <fields>` convention: a comma-separated field list is required, given
+// either as "--json a,b" or "--json=a,b". It deliberately has no
+// NoOptDefVal, so pflag always consumes the next argument as the field
+// list instead of letting it fall through as a positional arg.
+type jsonFields struct {
+	requested bool
+	fields    []string
+}
+
+func (f *jsonFields) String() string {
+	return strings.Join(f.fields, ",")
+}
+
+func (f *jsonFields) Set(v string) error {
+	if v == "" {
+		return fmt.Errorf("specify one or more comma-separated fields for `--json`: %s", strings.Join(statusRecordFields, ", "))
+	}
+
+	var fields []string
+	for _, name := range strings.Split(v, ",") {
+		if !isStatusRecordField(name) {
+			return fmt.Errorf("unknown JSON field: %q (available fields: %s)", name, strings.Join(statusRecordFields, ", "))
+		}
+		fields = append(fields, name)
+	}
+
+	f.requested = true
+	f.fields = fields
+
+	return nil
+}
+
+func (f *jsonFields) Type() string {
+	return "fields"
+}
+
+// resolved returns the fields --json was given, in the order requested.
+func (f *jsonFields) resolved() []string {
+	return f.fields
+}
+
+// outputOptions controls how get results are rendered: emoji-replaced text
+// (the default), JSON, or JSON piped through a jq expression or Go template.
+type outputOptions struct {
+	JSON     jsonFields
+	Jq       string
+	Template string
+}
+
+func (o *outputOptions) structured() bool {
+	return o.JSON.requested || o.Jq != "" || o.Template != ""
+}
+
+// statusRecord is the stable schema emitted for --json/--jq/--template.
+type statusRecord struct {
+	Login               string `json:"login"`
+	Message             string `json:"message"`
+	Emoji               string `json:"emoji"`
+	EmojiCodepoint      string `json:"emojiCodepoint"`
+	LimitedAvailability bool   `json:"limitedAvailability"`
+	ExpiresAt           string `json:"expiresAt"`
+}
+
+func newStatusRecord(em emojiManager, login string, s status) statusRecord {
+	return statusRecord{
+		Login:               login,
+		Message:             s.Message,
+		Emoji:               s.Emoji,
+		EmojiCodepoint:      lookupEmojiCodepoint(em, s.Emoji),
+		LimitedAvailability: s.IndicatesLimitedAvailability,
+		ExpiresAt:           s.ExpiresAt,
+	}
+}
+
+// lookupEmojiCodepoint resolves a ":shortcode:" like ":thought_balloon:" to
+// its unicode codepoint using the same emoji table ReplaceAll draws from.
+func lookupEmojiCodepoint(em emojiManager, shortcode string) string {
+	name := strings.Trim(shortcode, ":")
+	for _, e := range em.Emojis() {
+		for _, n := range e.names {
+			if n == name {
+				return string(e.codepoint)
+			}
+		}
+	}
+	return ""
+}
+
+// field looks up one of statusRecordFields by name, for building restricted
+// --json output.
+func (r statusRecord) field(name string) interface{} {
+	switch name {
+	case "login":
+		return r.Login
+	case "message":
+		return r.Message
+	case "emoji":
+		return r.Emoji
+	case "emojiCodepoint":
+		return r.EmojiCodepoint
+	case "limitedAvailability":
+		return r.LimitedAvailability
+	case "expiresAt":
+		return r.ExpiresAt
+	default:
+		return nil
+	}
+}
+
+// jsonEntry is one key/value pair of a field-restricted JSON object.
+type jsonEntry struct {
+	key   string
+	value interface{}
+}
+
+// orderedObject marshals as a JSON object that preserves the field order it
+// was built with, rather than the alphabetical order encoding/json would
+// otherwise impose on a map.
+type orderedObject []jsonEntry
+
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	var buf strings.Builder
+	buf.WriteByte('{')
+	for i, e := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(e.key)
+		if err != nil {
+			return nil, err
+		}
+		val, err := json.Marshal(e.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return []byte(buf.String()), nil
+}
+
+// renderOutput writes records per outOpts: JSON, jq-filtered JSON, or a Go
+// template. It writes nothing when none of those was requested; plain text
+// output is left to the caller. An
+// accompanying --json field restriction narrows what --jq/--template see
+// too, the same way gh's own flags compose.
+func renderOutput(outOpts outputOptions, records []statusRecord) error {
+	switch {
+	case outOpts.Template != "":
+		return renderTemplate(outOpts, records)
+	case outOpts.Jq != "":
+		return renderJq(outOpts, records)
+	case outOpts.JSON.requested:
+		return renderJSON(outOpts, records)
+	}
+
+	return nil
+}
+
+// selectedFields builds the field-restricted JSON objects for records,
+// honoring outOpts.JSON's field list, or every field if --json wasn't given.
+func selectedFields(outOpts outputOptions, records []statusRecord) []orderedObject {
+	fields := statusRecordFields
+	if outOpts.JSON.requested {
+		fields = outOpts.JSON.resolved()
+	}
+
+	objs := make([]orderedObject, len(records))
+	for i, r := range records {
+		obj := make(orderedObject, len(fields))
+		for j, f := range fields {
+			obj[j] = jsonEntry{key: f, value: r.field(f)}
+		}
+		objs[i] = obj
+	}
+
+	return objs
+}
+
+func renderJSON(outOpts outputOptions, records []statusRecord) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(selectedFields(outOpts, records))
+}
+
+func renderJq(outOpts outputOptions, records []statusRecord) error {
+	data, err := json.Marshal(selectedFields(outOpts, records))
+	if err != nil {
+		return err
+	}
+
+	var input interface{}
+	if err := json.Unmarshal(data, &input); err != nil {
+		return err
+	}
+
+	query, err := gojq.Parse(outOpts.Jq)
+	if err != nil {
+		return fmt.Errorf("invalid jq expression: %w", err)
+	}
+
+	iter := query.Run(input)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := v.(error); ok {
+			return err
+		}
+
+		out, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	}
+
+	return nil
+}
+
+// templateFuncs mirrors a subset of the helper funcs gh exposes to
+// --template: joining slices, and formatting the RFC3339 timestamps this
+// extension's API responses use.
+var templateFuncs = template.FuncMap{
+	"join": strings.Join,
+	"timefmt": func(layout, value string) (string, error) {
+		if value == "" {
+			return "", nil
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return "", err
+		}
+		return t.Format(layout), nil
+	},
+}
+
+// renderTemplate executes tmpl once over the full (optionally field-restricted)
+// slice of records, so gh-style templates like
+// "{{range .}}{{.login}}{{\"\\n\"}}{{end}}" work.
+func renderTemplate(outOpts outputOptions, records []statusRecord) error {
+	data, err := json.Marshal(selectedFields(outOpts, records))
+	if err != nil {
+		return err
+	}
+
+	var input interface{}
+	if err := json.Unmarshal(data, &input); err != nil {
+		return err
+	}
+
+	t, err := template.New("output").Funcs(templateFuncs).Parse(outOpts.Template)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+
+	return t.Execute(os.Stdout, input)
+}
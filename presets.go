@@ -0,0 +1,252 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// preset is a reusable status definition, as stored in presets.yaml.
+type preset struct {
+	Message string `yaml:"message"`
+	Emoji   string `yaml:"emoji"`
+	Limited bool   `yaml:"limited"`
+	Expiry  string `yaml:"expiry"`
+	Org     string `yaml:"org"`
+}
+
+type presetsFile struct {
+	Presets map[string]preset `yaml:"presets"`
+}
+
+// presetsPath returns the location of presets.yaml, honoring XDG_CONFIG_HOME.
+func presetsPath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "gh-user-status", "presets.yaml"), nil
+}
+
+func loadPresets() (presetsFile, error) {
+	path, err := presetsPath()
+	if err != nil {
+		return presetsFile{}, err
+	}
+
+	var pf presetsFile
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return presetsFile{Presets: map[string]preset{}}, nil
+		}
+		return presetsFile{}, err
+	}
+
+	if err := yaml.Unmarshal(data, &pf); err != nil {
+		return presetsFile{}, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if pf.Presets == nil {
+		pf.Presets = map[string]preset{}
+	}
+
+	return pf, nil
+}
+
+func savePresets(pf presetsFile) error {
+	path, err := presetsPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(pf)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+// applyPreset fills in any setOptions fields left at their zero value from p.
+func applyPreset(opts *setOptions, p preset) error {
+	if opts.Message == "" {
+		opts.Message = p.Message
+	}
+	if opts.Emoji == "" {
+		opts.Emoji = p.Emoji
+	}
+	if !opts.Limited {
+		opts.Limited = p.Limited
+	}
+	if opts.OrgName == "" {
+		opts.OrgName = p.Org
+	}
+	if opts.Expiry == 0 && p.Expiry != "" {
+		expiry, err := time.ParseDuration(p.Expiry)
+		if err != nil {
+			return fmt.Errorf("invalid expiry %q in preset: %w", p.Expiry, err)
+		}
+		opts.Expiry = expiry
+	}
+
+	if opts.Message == "" {
+		return fmt.Errorf("preset is missing a required message")
+	}
+
+	if opts.Emoji == "" {
+		opts.Emoji = "thought_balloon"
+	}
+
+	return nil
+}
+
+// loadSetOptionsFromFile reads a YAML or JSON file describing a single status
+// (the same shape as a preset) and applies it on top of opts.
+func loadSetOptionsFromFile(path string, opts *setOptions) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var p preset
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return applyPreset(opts, p)
+}
+
+func presetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "presets",
+		Short: "manage reusable status presets",
+	}
+	cmd.AddCommand(presetsListCmd())
+	cmd.AddCommand(presetsAddCmd())
+	cmd.AddCommand(presetsRemoveCmd())
+	cmd.AddCommand(presetsApplyCmd())
+
+	return cmd
+}
+
+func presetsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "list saved presets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pf, err := loadPresets()
+			if err != nil {
+				return err
+			}
+
+			names := make([]string, 0, len(pf.Presets))
+			for name := range pf.Presets {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			em := newEmojiManager()
+			for _, name := range names {
+				p := pf.Presets[name]
+				msg := fmt.Sprintf("%s: %s %s", name, p.Emoji, p.Message)
+				fmt.Println(em.ReplaceAll(msg))
+			}
+
+			return nil
+		},
+	}
+}
+
+func presetsAddCmd() *cobra.Command {
+	p := preset{}
+	cmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "add or update a preset",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if p.Message == "" {
+				return fmt.Errorf("--message is required")
+			}
+
+			pf, err := loadPresets()
+			if err != nil {
+				return err
+			}
+			pf.Presets[args[0]] = p
+
+			return savePresets(pf)
+		},
+	}
+	cmd.Flags().StringVar(&p.Message, "message", "", "status message")
+	cmd.Flags().StringVar(&p.Emoji, "emoji", "thought_balloon", "emoji for the status")
+	cmd.Flags().BoolVar(&p.Limited, "limited", false, "indicate limited availability")
+	cmd.Flags().StringVar(&p.Expiry, "expiry", "", "expire status after this duration (e.g. 4h)")
+	cmd.Flags().StringVar(&p.Org, "org", "", "limit status visibility to an organization")
+
+	return cmd
+}
+
+func presetsRemoveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove <name>",
+		Short: "remove a preset",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pf, err := loadPresets()
+			if err != nil {
+				return err
+			}
+			if _, ok := pf.Presets[args[0]]; !ok {
+				return fmt.Errorf("no preset named %q", args[0])
+			}
+			delete(pf.Presets, args[0])
+
+			return savePresets(pf)
+		},
+	}
+}
+
+func presetsApplyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "apply <name>",
+		Short: "set your status from a preset",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := setOptions{}
+			return applySetOptionsFromTemplate(args[0], &opts)
+		},
+	}
+}
+
+// applySetOptionsFromTemplate loads the named preset and runs runSet with it.
+func applySetOptionsFromTemplate(name string, opts *setOptions) error {
+	pf, err := loadPresets()
+	if err != nil {
+		return err
+	}
+
+	p, ok := pf.Presets[name]
+	if !ok {
+		return fmt.Errorf("no preset named %q", name)
+	}
+
+	if err := applyPreset(opts, p); err != nil {
+		return err
+	}
+
+	return runSet(*opts)
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+
+	"github.com/robfig/cron/v3"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// scheduleEntry fires preset (by name) at the given cron spec.
+type scheduleEntry struct {
+	Cron   string `yaml:"cron"`
+	Preset string `yaml:"preset"`
+}
+
+type scheduleFile struct {
+	Schedule []scheduleEntry `yaml:"schedule"`
+}
+
+func schedulePath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "gh-user-status", "schedule.yaml"), nil
+}
+
+func loadSchedule() (scheduleFile, error) {
+	path, err := schedulePath()
+	if err != nil {
+		return scheduleFile{}, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return scheduleFile{}, fmt.Errorf("no schedule configured; create %s first", path)
+		}
+		return scheduleFile{}, err
+	}
+
+	var sf scheduleFile
+	if err := yaml.Unmarshal(data, &sf); err != nil {
+		return scheduleFile{}, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return sf, nil
+}
+
+func scheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "rotate your status on a cron schedule",
+	}
+	cmd.AddCommand(scheduleRunCmd())
+	cmd.AddCommand(scheduleListCmd())
+	cmd.AddCommand(scheduleValidateCmd())
+	cmd.AddCommand(scheduleInstallCmd())
+
+	return cmd
+}
+
+func scheduleRunCmd() *cobra.Command {
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "run the scheduler in the foreground",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSchedule(dryRun)
+		},
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "print what would be applied instead of changing status")
+
+	return cmd
+}
+
+func runSchedule(dryRun bool) error {
+	sf, err := loadSchedule()
+	if err != nil {
+		return err
+	}
+
+	c := cron.New()
+	for _, entry := range sf.Schedule {
+		entry := entry
+		_, err := c.AddFunc(entry.Cron, func() {
+			if dryRun {
+				fmt.Printf("[dry-run] would apply preset %q (%s)\n", entry.Preset, entry.Cron)
+				return
+			}
+
+			opts := setOptions{}
+			if err := applySetOptionsFromTemplate(entry.Preset, &opts); err != nil {
+				fmt.Fprintf(os.Stderr, "failed to apply preset %q: %s\n", entry.Preset, err)
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("invalid cron expression %q: %w", entry.Cron, err)
+		}
+	}
+
+	fmt.Printf("scheduler running with %d job(s); press ctrl+c to stop\n", len(sf.Schedule))
+	c.Run()
+
+	return nil
+}
+
+func scheduleListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "list configured schedule entries",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sf, err := loadSchedule()
+			if err != nil {
+				return err
+			}
+
+			for _, entry := range sf.Schedule {
+				fmt.Printf("%s -> %s\n", entry.Cron, entry.Preset)
+			}
+
+			return nil
+		},
+	}
+}
+
+func scheduleValidateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "validate the schedule file and referenced presets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sf, err := loadSchedule()
+			if err != nil {
+				return err
+			}
+
+			pf, err := loadPresets()
+			if err != nil {
+				return err
+			}
+
+			parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+			var problems []string
+			for _, entry := range sf.Schedule {
+				if _, err := parser.Parse(entry.Cron); err != nil {
+					problems = append(problems, fmt.Sprintf("invalid cron %q: %s", entry.Cron, err))
+				}
+				if _, ok := pf.Presets[entry.Preset]; !ok {
+					problems = append(problems, fmt.Sprintf("unknown preset %q", entry.Preset))
+				}
+			}
+			sort.Strings(problems)
+
+			if len(problems) > 0 {
+				for _, p := range problems {
+					fmt.Println(p)
+				}
+				return fmt.Errorf("%d problem(s) found", len(problems))
+			}
+
+			fmt.Println("schedule is valid")
+			return nil
+		},
+	}
+}
+
+func scheduleInstallCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "install",
+		Short: "install a systemd user unit (or launchd plist on macOS) that runs the scheduler",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runtime.GOOS == "darwin" {
+				return installLaunchdAgent()
+			}
+			return installSystemdUnit()
+		},
+	}
+}
+
+func installSystemdUnit() error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+	dir := filepath.Join(home, ".config", "systemd", "user")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	unit := fmt.Sprintf(`[Unit]
+Description=gh-user-status scheduler
+
+[Service]
+ExecStart=%s schedule run
+Restart=on-failure
+
+[Install]
+WantedBy=default.target
+`, exe)
+
+	path := filepath.Join(dir, "gh-user-status.service")
+	if err := os.WriteFile(path, []byte(unit), 0o644); err != nil {
+		return err
+	}
+
+	fmt.Printf("wrote %s\nrun `systemctl --user enable --now gh-user-status` to start it\n", path)
+	return nil
+}
+
+func installLaunchdAgent() error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+	dir := filepath.Join(home, "Library", "LaunchAgents")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	plist := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>com.github.gh-user-status</string>
+	<key>ProgramArguments</key>
+	<array>
+		<string>%s</string>
+		<string>schedule</string>
+		<string>run</string>
+	</array>
+	<key>RunAtLoad</key>
+	<true/>
+	<key>KeepAlive</key>
+	<true/>
+</dict>
+</plist>
+`, exe)
+
+	path := filepath.Join(dir, "com.github.gh-user-status.plist")
+	if err := os.WriteFile(path, []byte(plist), 0o644); err != nil {
+		return err
+	}
+
+	fmt.Printf("wrote %s\nrun `launchctl load %s` to start it\n", path, path)
+	return nil
+}